@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Iamvibs/kubernetes-assistant-go/pkg/analyze"
+)
+
+// analyzeCmd scans a namespace for broken objects and explains each finding via the active
+// AI backend. Unlike the root command it is fully non-interactive, so it can run in CI/cron.
+func analyzeCmd() *cobra.Command {
+	var (
+		namespace string
+		output    string
+		explain   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "analyze",
+		Short: "Scan a namespace for broken objects and explain them",
+		Long:  "analyze enumerates crash-looping pods, unschedulable pods, unbound PVCs, and misconfigured HPAs in a namespace, explains each one via the active AI backend, and prints the results. It never prompts, so it is safe to run from CI or a cron job.",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			findings, err := scanAndExplain(context.Background(), namespace, explain)
+			if err != nil {
+				return err
+			}
+
+			return analyze.Write(os.Stdout, output, findings)
+		},
+	}
+
+	cmd.Flags().StringVar(&namespace, "namespace", "default", "The namespace to scan.")
+	cmd.Flags().StringVar(&output, "output", "text", "The output format: json, yaml, or text.")
+	cmd.Flags().BoolVar(&explain, "explain", true, "Whether to ask the AI backend to explain each finding in plain English.")
+
+	return cmd
+}
@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/Iamvibs/kubernetes-assistant-go/pkg/ai"
+	"github.com/Iamvibs/kubernetes-assistant-go/pkg/config"
+)
+
+// authCmd groups the subcommands used to manage registered AI backends.
+func authCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auth",
+		Short: "Manage AI backend credentials",
+		Long:  "auth registers, removes, and lists the AI backends kubectl-assistant can use, and sets which one is the default.",
+	}
+
+	cmd.AddCommand(authAddCmd(), authRemoveCmd(), authListCmd(), authDefaultCmd())
+
+	return cmd
+}
+
+func authAddCmd() *cobra.Command {
+	var (
+		provider    string
+		model       string
+		baseURL     string
+		engine      string
+		password    string
+		temperature float64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "add <name>",
+		Short: "Register a new AI backend",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			cfg := ai.Config{
+				Name:        args[0],
+				Provider:    provider,
+				Model:       model,
+				BaseURL:     baseURL,
+				Engine:      engine,
+				Password:    password,
+				Temperature: temperature,
+			}
+
+			// Validate eagerly so a typo'd provider name doesn't get persisted.
+			if _, err := ai.New(cfg); err != nil {
+				return err
+			}
+
+			store, err := config.Load()
+			if err != nil {
+				return err
+			}
+
+			if err := store.AddBackend(cfg); err != nil {
+				return err
+			}
+
+			fmt.Printf("Backend %q added.\n", cfg.Name)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&provider, "provider", "openai", "The provider type for this backend (openai, azure, localai).")
+	cmd.Flags().StringVar(&model, "model", "", "The model name to use for this backend.")
+	cmd.Flags().StringVar(&baseURL, "baseurl", "", "The base URL for this backend's API.")
+	cmd.Flags().StringVar(&engine, "engine", "", "The engine/deployment name, used by the azure provider.")
+	cmd.Flags().StringVar(&password, "password", "", "The API key/password for this backend.")
+	cmd.Flags().Float64Var(&temperature, "temperature", 0.0, "The default temperature for this backend.")
+
+	return cmd
+}
+
+func authRemoveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove <name>",
+		Short: "Remove a registered AI backend",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			store, err := config.Load()
+			if err != nil {
+				return err
+			}
+
+			if err := store.RemoveBackend(args[0]); err != nil {
+				return err
+			}
+
+			fmt.Printf("Backend %q removed.\n", args[0])
+
+			return nil
+		},
+	}
+}
+
+func authListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List registered AI backends",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			store, err := config.Load()
+			if err != nil {
+				return err
+			}
+
+			cfgs, err := store.Backends()
+			if err != nil {
+				return err
+			}
+
+			def := store.Default()
+			for _, cfg := range cfgs {
+				marker := " "
+				if cfg.Name == def {
+					marker = "*"
+				}
+
+				fmt.Printf("%s %s\t%s\t%s\n", marker, cfg.Name, cfg.Provider, cfg.Model)
+			}
+
+			return nil
+		},
+	}
+}
+
+func authDefaultCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "default <name>",
+		Short: "Set the default AI backend",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			store, err := config.Load()
+			if err != nil {
+				return err
+			}
+
+			if err := store.SetDefault(args[0]); err != nil {
+				return err
+			}
+
+			fmt.Printf("Default backend set to %q.\n", args[0])
+
+			return nil
+		},
+	}
+}
+
+// loadRegistry reads every configured backend from disk into an in-memory registry.
+func loadRegistry() (*ai.Registry, error) {
+	store, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	cfgs, err := store.Backends()
+	if err != nil {
+		return nil, err
+	}
+
+	reg := ai.NewRegistry()
+	for _, cfg := range cfgs {
+		if err := reg.Add(cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if def := store.Default(); def != "" {
+		// A stale default (e.g. the backend it pointed at was since removed) shouldn't
+		// take down the whole registry; keep the registry's own default (the first backend
+		// added) rather than erroring.
+		if err := reg.SetDefault(def); err != nil {
+			log.Warnf("ignoring stale default backend %q: %v", def, err)
+		}
+	}
+
+	return reg, nil
+}
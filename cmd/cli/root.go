@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 
 	"github.com/janeczku/go-spinner"
 	"github.com/manifoldco/promptui"
@@ -13,7 +14,12 @@ import (
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 	"github.com/walles/env"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/Iamvibs/kubernetes-assistant-go/pkg/ai"
+	"github.com/Iamvibs/kubernetes-assistant-go/pkg/anonymize"
 )
 
 const (
@@ -37,13 +43,18 @@ var (
 	usek8sAPI            = flag.Bool("use-k8s-api", env.GetOr("USE_K8S_API", strconv.ParseBool, false), "Whether to use the Kubernetes API to create resources with function calling. Defaults to false.")                                                                                         // Whether to use the Kubernetes API to create resources with function calling.
 	k8sOpenAPIURL        = flag.String("k8s-openapi-url", env.GetOr("K8S_OPENAPI_URL", env.String, ""), "The URL to a Kubernetes OpenAPI spec. Only used if use-k8s-api flag is true.")                                                                                                            // The URL to a Kubernetes OpenAPI spec.
 	debug                = flag.Bool("debug", env.GetOr("DEBUG", strconv.ParseBool, false), "Whether to print debug logs. Defaults to false.")                                                                                                                                                     // Whether to print debug logs.
+	backend              = flag.String("backend", env.GetOr("BACKEND", env.String, ""), "The name of a registered AI backend (see `auth add`), or an ad-hoc provider type (openai, azure, localai) to use without registering one.")                                                              // The name or provider type of the active AI backend.
+	baseURL              = flag.String("baseurl", env.GetOr("BASEURL", env.String, ""), "The base URL to use when --backend refers to an ad-hoc provider type rather than a registered backend, e.g. your LocalAI server.")                                                                       // The base URL for an ad-hoc backend.
+	model                = flag.String("model", env.GetOr("MODEL", env.String, ""), "The model to use when --backend refers to an ad-hoc provider type rather than a registered backend. Defaults to --openai-deployment-name.")                                                                  // The model for an ad-hoc backend.
+	anonymizePrompt      = flag.Bool("anonymize", env.GetOr("ANONYMIZE", strconv.ParseBool, false), "Whether to scrub sensitive Kubernetes identifiers from the prompt before sending it to the AI backend, restoring them in the response. Defaults to false.")                                  // Whether to anonymize sensitive identifiers in the prompt.
 )
 
 // InitAndExecute initializes the application and executes the root command.
-// It checks if the OpenAI key is provided and exits if it is not.
+// It checks if the OpenAI key is provided and exits if it is not, unless the selected
+// backend (registered via `auth add`, or an ad-hoc --backend type) doesn't require one.
 // It then executes the root command.
 func InitAndExecute() {
-	if *openAIAPIKey == "" {
+	if *openAIAPIKey == "" && !hasPasswordlessBackend() {
 		fmt.Println("Please provide an OpenAI key.")
 		os.Exit(1)
 	}
@@ -53,6 +64,17 @@ func InitAndExecute() {
 	}
 }
 
+// hasPasswordlessBackend reports whether the selected backend (registered or ad-hoc) can
+// operate without an API key, so InitAndExecute can skip the mandatory key check for it.
+func hasPasswordlessBackend() bool {
+	p, err := activeProvider()
+	if err != nil {
+		return false
+	}
+
+	return !p.RequiresPassword()
+}
+
 // RootCmd returns the root command for the kubectl-assistant CLI.
 // It sets up the command with the necessary flags, pre-run actions, and the main run function.
 func RootCmd() *cobra.Command {
@@ -88,6 +110,10 @@ func RootCmd() *cobra.Command {
 	// Add Kubernetes configuration flags to the command
 	kubernetesConfigFlags.AddFlags(cmd.PersistentFlags())
 
+	cmd.AddCommand(authCmd())
+	cmd.AddCommand(analyzeCmd())
+	cmd.AddCommand(serveCmd())
+
 	return cmd
 }
 
@@ -98,6 +124,135 @@ func printDebugFlags() {
 	log.Debugf("temperature: %f", *temperature)
 	log.Debugf("use-k8s-api: %t", *usek8sAPI)
 	log.Debugf("k8s-openapi-url: %s", *k8sOpenAPIURL)
+	log.Debugf("backend: %s", *backend)
+	log.Debugf("baseurl: %s", *baseURL)
+	log.Debugf("model: %s", *model)
+	log.Debugf("anonymize: %t", *anonymizePrompt)
+}
+
+// activeProvider resolves the AI backend to use for this invocation.
+//
+// If --backend names a backend registered via `auth add`, that one is used (or the
+// registered default, if --backend is empty and at least one backend is registered).
+// Otherwise --backend is treated as an ad-hoc provider type (openai, azure, localai) and a
+// provider is built directly from --baseurl/--model/--openai-api-key/--temperature (for
+// azure, the engine is the deployment --azure-openai-map gives for the model), so
+// single-shot usage against e.g. a LocalAI server doesn't require running `auth add` first.
+func activeProvider() (ai.AIProvider, error) {
+	reg, err := loadRegistry()
+	if err == nil {
+		if p, err := reg.Get(*backend); err == nil {
+			if *baseURL != "" || *model != "" {
+				log.Debugf("ignoring --baseurl/--model: backend %q is a registered backend, not an ad-hoc provider type", p.Name())
+			}
+
+			return p, nil
+		}
+	}
+
+	providerType := *backend
+	if providerType == "" {
+		providerType = "openai"
+	}
+
+	adhocModel := *model
+	if adhocModel == "" {
+		adhocModel = *openAIDeploymentName
+	}
+
+	adhocBaseURL := *baseURL
+	if adhocBaseURL == "" {
+		adhocBaseURL = *openAIEndpoint
+	}
+
+	cfg := ai.Config{
+		Name:        "default",
+		Provider:    providerType,
+		Model:       adhocModel,
+		BaseURL:     adhocBaseURL,
+		Engine:      (*azureModelMap)[adhocModel],
+		Password:    *openAIAPIKey,
+		Temperature: *temperature,
+	}
+
+	return ai.New(cfg)
+}
+
+// completeOnce runs a single prompt through provider, applying anonymization around the
+// call when --anonymize is set. candidates are the sensitive values (namespace/resource
+// names, etc.) known to appear in prompt; they are masked if found, every other value is
+// left untouched. Passing a previously returned mapping back in keeps masked identifiers
+// stable across a reprompt loop; pass nil on the first call. It is the shared completion
+// building block used by the interactive CLI loop in run(), the `analyze` subcommand, and the
+// non-interactive `serve` HTTP endpoints; it does not apply the result.
+func completeOnce(ctx context.Context, provider ai.AIProvider, prompt string, candidates []string, mapping map[string]string) (string, map[string]string, error) {
+	if *anonymizePrompt {
+		prompt, mapping = anonymize.Mask(prompt, candidates, mapping)
+	}
+
+	completion, err := provider.GetCompletion(ctx, prompt)
+	if err != nil {
+		return "", mapping, err
+	}
+
+	if *anonymizePrompt {
+		completion = anonymize.Unmask(completion, mapping)
+	}
+
+	return completion, mapping, nil
+}
+
+// k8sDiscoveredCandidates returns the namespace, object names, and label/annotation values
+// of every pod in the active namespace, so they can be offered to anonymize.Mask as
+// candidates alongside the typed prompt args. It only does anything when --use-k8s-api is
+// set, matching the scope of function-calling against the live cluster; any error talking to
+// the cluster is logged at debug level and treated as "nothing to mask", not fatal to run().
+func k8sDiscoveredCandidates(ctx context.Context) []string {
+	if !*usek8sAPI {
+		return nil
+	}
+
+	restConfig, err := kubernetesConfigFlags.ToRESTConfig()
+	if err != nil {
+		log.Debugf("use-k8s-api: skipping identifier discovery: %v", err)
+
+		return nil
+	}
+
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		log.Debugf("use-k8s-api: skipping identifier discovery: %v", err)
+
+		return nil
+	}
+
+	namespace := "default"
+	if kubernetesConfigFlags.Namespace != nil && *kubernetesConfigFlags.Namespace != "" {
+		namespace = *kubernetesConfigFlags.Namespace
+	}
+
+	candidates := []string{namespace}
+
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Debugf("use-k8s-api: skipping pod discovery: %v", err)
+
+		return candidates
+	}
+
+	for _, pod := range pods.Items {
+		candidates = append(candidates, pod.Name)
+
+		for _, v := range pod.Labels {
+			candidates = append(candidates, v)
+		}
+
+		for _, v := range pod.Annotations {
+			candidates = append(candidates, v)
+		}
+	}
+
+	return candidates
 }
 
 // run is the main function that executes the CLI command.
@@ -106,13 +261,21 @@ func run(args []string) error {
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
 	defer cancel()
 
-	// Create new OAI clients
-	oaiClients, err := newOAIClients()
+	// Resolve the active AI backend
+	provider, err := activeProvider()
 	if err != nil {
 		return err
 	}
 
+	// The candidate identifiers anonymize.Mask should look for: the prompt args as typed by
+	// the user, plus (when --use-k8s-api is set) the namespace and pod metadata found by
+	// listing pods in the cluster. The action appended to args on each reprompt is not a
+	// candidate.
+	candidates := append([]string(nil), args...)
+	candidates = append(candidates, k8sDiscoveredCandidates(ctx)...)
+
 	var action, completion string
+	var maskMapping map[string]string
 	for action != apply {
 		args = append(args, action)
 
@@ -123,8 +286,7 @@ func run(args []string) error {
 			s.Start()
 		}
 
-		// Get GPT completion for the given arguments
-		completion, err = gptCompletion(ctx, oaiClients, args, *openAIDeploymentName)
+		completion, maskMapping, err = completeOnce(ctx, provider, strings.Join(args, " "), candidates, maskMapping)
 		if err != nil {
 			return err
 		}
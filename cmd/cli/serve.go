@@ -0,0 +1,228 @@
+package cli
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/Iamvibs/kubernetes-assistant-go/pkg/analyze"
+)
+
+// serveCmd starts a long-running HTTP server exposing the same completion and analysis
+// pipeline as the CLI, so other controllers or chat frontends can call kubectl-assistant
+// in-cluster instead of shelling out to it.
+func serveCmd() *cobra.Command {
+	var (
+		listen  string
+		token   string
+		tlsCert string
+		tlsKey  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Start an HTTP API for prompts and analysis",
+		Long:  "serve starts an HTTP server exposing POST /generate and GET /analyze, reusing the same AI backend registry and Kubernetes client as the CLI, so kubectl-assistant can run as a long-lived in-cluster assistant.",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return runServe(listen, token, tlsCert, tlsKey)
+		},
+	}
+
+	cmd.Flags().StringVar(&listen, "listen", ":8080", "The address to listen on.")
+	cmd.Flags().StringVar(&token, "token", "", "If set, require this bearer token on every request.")
+	cmd.Flags().StringVar(&tlsCert, "tls-cert", "", "Path to a TLS certificate. Requires --tls-key.")
+	cmd.Flags().StringVar(&tlsKey, "tls-key", "", "Path to a TLS private key. Requires --tls-cert.")
+
+	return cmd
+}
+
+func runServe(listen, token, tlsCert, tlsKey string) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/generate", handleGenerate)
+	mux.HandleFunc("/analyze", handleAnalyze)
+
+	server := &http.Server{
+		Addr:    listen,
+		Handler: requireBearerToken(token, mux),
+	}
+
+	log.Infof("kubectl-assistant serve listening on %s", listen)
+
+	if tlsCert != "" || tlsKey != "" {
+		if tlsCert == "" || tlsKey == "" {
+			return fmt.Errorf("--tls-cert and --tls-key must be set together")
+		}
+
+		return server.ListenAndServeTLS(tlsCert, tlsKey)
+	}
+
+	return server.ListenAndServe()
+}
+
+// requireBearerToken wraps next with bearer-token auth. If token is empty, auth is disabled.
+func requireBearerToken(token string, next http.Handler) http.Handler {
+	if token == "" {
+		return next
+	}
+
+	want := []byte("Bearer " + token)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got := []byte(r.Header.Get("Authorization"))
+		if len(got) != len(want) || subtle.ConstantTimeCompare(got, want) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+type generateRequest struct {
+	Prompt    string `json:"prompt"`
+	Namespace string `json:"namespace"`
+}
+
+type generateResponse struct {
+	Manifest string `json:"manifest"`
+}
+
+func handleGenerate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	var req generateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	if req.Prompt == "" {
+		http.Error(w, "prompt must be provided", http.StatusBadRequest)
+
+		return
+	}
+
+	provider, err := activeProvider()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	args := []string{req.Prompt}
+
+	var candidates []string
+	if req.Namespace != "" {
+		args = append(args, "--namespace", req.Namespace)
+		candidates = append(candidates, req.Namespace)
+	}
+
+	manifest, _, err := completeOnce(r.Context(), provider, strings.Join(args, " "), candidates, nil)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, generateResponse{Manifest: manifest})
+}
+
+func handleAnalyze(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	namespace := r.URL.Query().Get("namespace")
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	explain := true
+	if v := r.URL.Query().Get("explain"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			http.Error(w, "invalid explain value: "+err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		explain = parsed
+	}
+
+	findings, err := scanAndExplain(r.Context(), namespace, explain)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, findings)
+}
+
+// scanAndExplain runs the same scan-then-explain pipeline as the `analyze` CLI subcommand,
+// returning the findings instead of writing them to stdout.
+func scanAndExplain(ctx context.Context, namespace string, explain bool) ([]analyze.Finding, error) {
+	restConfig, err := kubernetesConfigFlags.ToRESTConfig()
+	if err != nil {
+		return nil, fmt.Errorf("building kubernetes client config: %w", err)
+	}
+
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("building kubernetes client: %w", err)
+	}
+
+	findings, err := analyze.Scan(ctx, client, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if !explain || len(findings) == 0 {
+		return findings, nil
+	}
+
+	provider, err := activeProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	var maskMapping map[string]string
+
+	for i, f := range findings {
+		prompt := fmt.Sprintf("Explain in plain English why this Kubernetes %s named %q in namespace %q is broken: %s (%s)", f.Kind, f.Name, f.Namespace, f.Reason, f.Detail)
+		candidates := []string{f.Name, f.Namespace}
+
+		explanation, mapping, err := completeOnce(ctx, provider, prompt, candidates, maskMapping)
+		if err != nil {
+			return nil, fmt.Errorf("explaining %s/%s: %w", f.Kind, f.Name, err)
+		}
+
+		maskMapping = mapping
+		findings[i].Explanation = explanation
+	}
+
+	return findings, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
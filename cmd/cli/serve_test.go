@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequireBearerTokenDisabledWhenTokenEmpty(t *testing.T) {
+	called := false
+	handler := requireBearerToken("", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/generate", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if !called {
+		t.Fatal("expected the wrapped handler to run when no token is configured")
+	}
+}
+
+func TestRequireBearerTokenRejectsMissingOrWrongToken(t *testing.T) {
+	handler := requireBearerToken("secret", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("handler should not run for an unauthenticated request")
+	}))
+
+	cases := []string{"", "Bearer wrong", "Bearer secre", "secret", "bearer secret"}
+	for _, authHeader := range cases {
+		req := httptest.NewRequest(http.MethodGet, "/generate", nil)
+		if authHeader != "" {
+			req.Header.Set("Authorization", authHeader)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Authorization %q: expected 401, got %d", authHeader, rec.Code)
+		}
+	}
+}
+
+func TestRequireBearerTokenAcceptsCorrectToken(t *testing.T) {
+	called := false
+	handler := requireBearerToken("secret", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/generate", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	if !called {
+		t.Fatal("expected the wrapped handler to run for a correctly authenticated request")
+	}
+}
@@ -0,0 +1,66 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+func init() {
+	Register("azure", func() AIProvider { return &azureProvider{} })
+}
+
+// azureProvider talks to an Azure OpenAI Service deployment. Unlike hosted OpenAI, Azure
+// addresses models by deployment ("engine") name rather than model name.
+type azureProvider struct {
+	cfg    Config
+	client *openai.Client
+}
+
+func (p *azureProvider) Name() string { return p.cfg.Name }
+
+func (p *azureProvider) Configure(cfg Config) error {
+	if cfg.Password == "" {
+		return fmt.Errorf("azure backend %q requires an api key", cfg.Name)
+	}
+
+	if cfg.BaseURL == "" {
+		return fmt.Errorf("azure backend %q requires a baseurl", cfg.Name)
+	}
+
+	if cfg.Engine == "" {
+		return fmt.Errorf("azure backend %q requires an engine (deployment name)", cfg.Name)
+	}
+
+	clientConfig := openai.DefaultAzureConfig(cfg.Password, cfg.BaseURL)
+	clientConfig.AzureModelMapperFunc = func(model string) string {
+		return cfg.Engine
+	}
+
+	p.cfg = cfg
+	p.client = openai.NewClientWithConfig(clientConfig)
+
+	return nil
+}
+
+func (p *azureProvider) RequiresPassword() bool { return true }
+
+func (p *azureProvider) GetCompletion(ctx context.Context, prompt string) (string, error) {
+	resp, err := p.client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model:       p.cfg.Model,
+		Temperature: float32(p.cfg.Temperature),
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleUser, Content: prompt},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("azure completion: %w", err)
+	}
+
+	if len(resp.Choices) == 0 {
+		return "", fmt.Errorf("azure completion: no choices returned")
+	}
+
+	return resp.Choices[0].Message.Content, nil
+}
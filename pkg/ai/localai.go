@@ -0,0 +1,56 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+func init() {
+	Register("localai", func() AIProvider { return &localAIProvider{} })
+}
+
+// localAIProvider talks to a self-hosted, OpenAI-API-compatible inference server such as
+// LocalAI or llama.cpp's server mode. It does not require a password.
+type localAIProvider struct {
+	cfg    Config
+	client *openai.Client
+}
+
+func (p *localAIProvider) Name() string { return p.cfg.Name }
+
+func (p *localAIProvider) Configure(cfg Config) error {
+	if cfg.BaseURL == "" {
+		return fmt.Errorf("localai backend %q requires a baseurl", cfg.Name)
+	}
+
+	clientConfig := openai.DefaultConfig(cfg.Password)
+	clientConfig.BaseURL = cfg.BaseURL
+
+	p.cfg = cfg
+	p.client = openai.NewClientWithConfig(clientConfig)
+
+	return nil
+}
+
+func (p *localAIProvider) RequiresPassword() bool { return false }
+
+func (p *localAIProvider) GetCompletion(ctx context.Context, prompt string) (string, error) {
+	resp, err := p.client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model:       p.cfg.Model,
+		Temperature: float32(p.cfg.Temperature),
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleUser, Content: prompt},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("localai completion: %w", err)
+	}
+
+	if len(resp.Choices) == 0 {
+		return "", fmt.Errorf("localai completion: no choices returned")
+	}
+
+	return resp.Choices[0].Message.Content, nil
+}
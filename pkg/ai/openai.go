@@ -0,0 +1,61 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+func init() {
+	Register("openai", func() AIProvider { return &openAIProvider{} })
+}
+
+// openAIProvider talks to the hosted OpenAI API.
+type openAIProvider struct {
+	cfg    Config
+	client *openai.Client
+}
+
+func (p *openAIProvider) Name() string { return p.cfg.Name }
+
+func (p *openAIProvider) Configure(cfg Config) error {
+	if cfg.Password == "" {
+		return fmt.Errorf("openai backend %q requires an api key", cfg.Name)
+	}
+
+	if cfg.Model == "" {
+		cfg.Model = openai.GPT3Dot5Turbo0301
+	}
+
+	clientConfig := openai.DefaultConfig(cfg.Password)
+	if cfg.BaseURL != "" {
+		clientConfig.BaseURL = cfg.BaseURL
+	}
+
+	p.cfg = cfg
+	p.client = openai.NewClientWithConfig(clientConfig)
+
+	return nil
+}
+
+func (p *openAIProvider) RequiresPassword() bool { return true }
+
+func (p *openAIProvider) GetCompletion(ctx context.Context, prompt string) (string, error) {
+	resp, err := p.client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model:       p.cfg.Model,
+		Temperature: float32(p.cfg.Temperature),
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleUser, Content: prompt},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("openai completion: %w", err)
+	}
+
+	if len(resp.Choices) == 0 {
+		return "", fmt.Errorf("openai completion: no choices returned")
+	}
+
+	return resp.Choices[0].Message.Content, nil
+}
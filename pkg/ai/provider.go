@@ -0,0 +1,67 @@
+// Package ai defines a pluggable abstraction over the various LLM backends
+// that kubectl-assistant can talk to (hosted OpenAI, Azure OpenAI, LocalAI, ...).
+package ai
+
+import "context"
+
+// Config holds the settings needed to construct and persist a single backend.
+// Not every field is used by every provider; providers ignore what they don't need.
+type Config struct {
+	Name        string  `mapstructure:"name" yaml:"name"`
+	Provider    string  `mapstructure:"provider" yaml:"provider"`
+	Model       string  `mapstructure:"model" yaml:"model"`
+	BaseURL     string  `mapstructure:"baseurl" yaml:"baseurl"`
+	Engine      string  `mapstructure:"engine" yaml:"engine"`
+	Password    string  `mapstructure:"password" yaml:"password"`
+	Temperature float64 `mapstructure:"temperature" yaml:"temperature"`
+}
+
+// AIProvider is implemented by every backend kubectl-assistant can complete prompts against.
+type AIProvider interface {
+	// Name returns the unique name this provider instance was registered under.
+	Name() string
+
+	// Configure applies the given config to the provider, validating it along the way.
+	Configure(cfg Config) error
+
+	// GetCompletion sends the prompt to the backend and returns the generated text.
+	GetCompletion(ctx context.Context, prompt string) (string, error)
+
+	// RequiresPassword reports whether this provider needs an API key/password to operate.
+	RequiresPassword() bool
+}
+
+// Factory builds a new, unconfigured AIProvider for a given provider type (e.g. "openai").
+type Factory func() AIProvider
+
+var factories = map[string]Factory{}
+
+// Register adds a provider factory under the given provider type name.
+// Called from each provider's init() function.
+func Register(providerType string, factory Factory) {
+	factories[providerType] = factory
+}
+
+// New builds a provider instance for the given provider type and configures it.
+func New(cfg Config) (AIProvider, error) {
+	factory, ok := factories[cfg.Provider]
+	if !ok {
+		return nil, &UnknownProviderError{ProviderType: cfg.Provider}
+	}
+
+	p := factory()
+	if err := p.Configure(cfg); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// UnknownProviderError is returned by New when no factory is registered for the requested type.
+type UnknownProviderError struct {
+	ProviderType string
+}
+
+func (e *UnknownProviderError) Error() string {
+	return "unknown ai provider type: " + e.ProviderType
+}
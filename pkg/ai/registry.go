@@ -0,0 +1,80 @@
+package ai
+
+import "fmt"
+
+// Registry holds a set of configured providers, keyed by the name they were registered under,
+// plus a pointer to which one is the default.
+type Registry struct {
+	providers map[string]AIProvider
+	def       string
+}
+
+// NewRegistry returns an empty provider registry.
+func NewRegistry() *Registry {
+	return &Registry{providers: map[string]AIProvider{}}
+}
+
+// Add configures and adds a provider under cfg.Name. If this is the first provider added,
+// it becomes the default.
+func (r *Registry) Add(cfg Config) error {
+	p, err := New(cfg)
+	if err != nil {
+		return fmt.Errorf("adding backend %q: %w", cfg.Name, err)
+	}
+
+	r.providers[cfg.Name] = p
+	if r.def == "" {
+		r.def = cfg.Name
+	}
+
+	return nil
+}
+
+// Remove deletes a provider by name, clearing the default if it pointed at the removed provider.
+func (r *Registry) Remove(name string) {
+	delete(r.providers, name)
+	if r.def == name {
+		r.def = ""
+	}
+}
+
+// SetDefault marks the named provider as the default one returned by Get(""). Returns an error
+// if no such provider is registered.
+func (r *Registry) SetDefault(name string) error {
+	if _, ok := r.providers[name]; !ok {
+		return fmt.Errorf("no such backend: %s", name)
+	}
+
+	r.def = name
+
+	return nil
+}
+
+// Default returns the name of the default provider, or "" if none is set.
+func (r *Registry) Default() string {
+	return r.def
+}
+
+// Get returns the provider registered under name, or the default provider if name is empty.
+func (r *Registry) Get(name string) (AIProvider, error) {
+	if name == "" {
+		name = r.def
+	}
+
+	p, ok := r.providers[name]
+	if !ok {
+		return nil, fmt.Errorf("no such backend: %s", name)
+	}
+
+	return p, nil
+}
+
+// Names returns the names of all registered providers.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+
+	return names
+}
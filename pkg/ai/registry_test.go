@@ -0,0 +1,68 @@
+package ai
+
+import "testing"
+
+func TestRegistryAddGetAndDefault(t *testing.T) {
+	reg := NewRegistry()
+
+	if err := reg.Add(Config{Name: "a", Provider: "localai", BaseURL: "http://localhost:8080"}); err != nil {
+		t.Fatalf("Add(a): %v", err)
+	}
+
+	if err := reg.Add(Config{Name: "b", Provider: "localai", BaseURL: "http://localhost:8081"}); err != nil {
+		t.Fatalf("Add(b): %v", err)
+	}
+
+	if got := reg.Default(); got != "a" {
+		t.Fatalf("expected first-added backend to become the default, got %q", got)
+	}
+
+	if _, err := reg.Get(""); err != nil {
+		t.Fatalf("Get(\"\") should resolve the default: %v", err)
+	}
+
+	if err := reg.SetDefault("b"); err != nil {
+		t.Fatalf("SetDefault(b): %v", err)
+	}
+
+	p, err := reg.Get("")
+	if err != nil {
+		t.Fatalf("Get(\"\") after SetDefault: %v", err)
+	}
+
+	if p.Name() != "b" {
+		t.Fatalf("expected default to be %q, got %q", "b", p.Name())
+	}
+}
+
+func TestRegistrySetDefaultRejectsUnknownName(t *testing.T) {
+	reg := NewRegistry()
+
+	if err := reg.SetDefault("nope"); err == nil {
+		t.Fatal("expected an error setting an unregistered backend as default")
+	}
+}
+
+func TestRegistryRemove(t *testing.T) {
+	reg := NewRegistry()
+
+	if err := reg.Add(Config{Name: "a", Provider: "localai", BaseURL: "http://localhost:8080"}); err != nil {
+		t.Fatalf("Add(a): %v", err)
+	}
+
+	reg.Remove("a")
+
+	if _, err := reg.Get("a"); err == nil {
+		t.Fatal("expected Get to fail for a removed backend")
+	}
+
+	if got := reg.Default(); got != "" {
+		t.Fatalf("expected default to be cleared after removing it, got %q", got)
+	}
+}
+
+func TestNewRejectsUnknownProviderType(t *testing.T) {
+	if _, err := New(Config{Name: "a", Provider: "does-not-exist"}); err == nil {
+		t.Fatal("expected an error for an unregistered provider type")
+	}
+}
@@ -0,0 +1,151 @@
+// Package analyze scans a namespace for commonly-broken Kubernetes objects (crash-looping
+// pods, unschedulable pods, failing PVCs, misconfigured HPAs) so they can be explained and
+// reported on without a human driving kubectl by hand.
+package analyze
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Finding describes a single broken object discovered in the cluster.
+type Finding struct {
+	Kind      string `json:"kind" yaml:"kind"`
+	Namespace string `json:"namespace" yaml:"namespace"`
+	Name      string `json:"name" yaml:"name"`
+	Reason    string `json:"reason" yaml:"reason"`
+	Detail    string `json:"detail" yaml:"detail"`
+
+	// Explanation is filled in by the caller after running Reason/Detail through an LLM.
+	Explanation string `json:"explanation,omitempty" yaml:"explanation,omitempty"`
+}
+
+// Scan enumerates broken objects in namespace and returns one Finding per problem found.
+func Scan(ctx context.Context, client kubernetes.Interface, namespace string) ([]Finding, error) {
+	var findings []Finding
+
+	podFindings, err := scanPods(ctx, client, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("scanning pods: %w", err)
+	}
+
+	findings = append(findings, podFindings...)
+
+	pvcFindings, err := scanPVCs(ctx, client, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("scanning persistent volume claims: %w", err)
+	}
+
+	findings = append(findings, pvcFindings...)
+
+	hpaFindings, err := scanHPAs(ctx, client, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("scanning horizontal pod autoscalers: %w", err)
+	}
+
+	findings = append(findings, hpaFindings...)
+
+	return findings, nil
+}
+
+func scanPods(ctx context.Context, client kubernetes.Interface, namespace string) ([]Finding, error) {
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+
+	for _, pod := range pods.Items {
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.State.Waiting != nil && cs.State.Waiting.Reason == "CrashLoopBackOff" {
+				findings = append(findings, Finding{
+					Kind:      "Pod",
+					Namespace: pod.Namespace,
+					Name:      pod.Name,
+					Reason:    "CrashLoopBackOff",
+					Detail:    fmt.Sprintf("container %q is crash-looping: %s", cs.Name, cs.State.Waiting.Message),
+				})
+			}
+		}
+
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse && cond.Reason == "Unschedulable" {
+				findings = append(findings, Finding{
+					Kind:      "Pod",
+					Namespace: pod.Namespace,
+					Name:      pod.Name,
+					Reason:    "Unschedulable",
+					Detail:    cond.Message,
+				})
+			}
+		}
+	}
+
+	return findings, nil
+}
+
+func scanPVCs(ctx context.Context, client kubernetes.Interface, namespace string) ([]Finding, error) {
+	pvcs, err := client.CoreV1().PersistentVolumeClaims(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+
+	for _, pvc := range pvcs.Items {
+		if pvc.Status.Phase != corev1.ClaimBound && pvc.Status.Phase != "" {
+			findings = append(findings, Finding{
+				Kind:      "PersistentVolumeClaim",
+				Namespace: pvc.Namespace,
+				Name:      pvc.Name,
+				Reason:    string(pvc.Status.Phase),
+				Detail:    fmt.Sprintf("claim has not bound (phase: %s)", pvc.Status.Phase),
+			})
+		}
+	}
+
+	return findings, nil
+}
+
+func scanHPAs(ctx context.Context, client kubernetes.Interface, namespace string) ([]Finding, error) {
+	hpas, err := client.AutoscalingV1().HorizontalPodAutoscalers(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+
+	for _, hpa := range hpas.Items {
+		target := hpa.Spec.ScaleTargetRef
+		if target.Name == "" {
+			findings = append(findings, Finding{
+				Kind:      "HorizontalPodAutoscaler",
+				Namespace: hpa.Namespace,
+				Name:      hpa.Name,
+				Reason:    "MissingScaleTarget",
+				Detail:    "spec.scaleTargetRef has no name set",
+			})
+
+			continue
+		}
+
+		for _, cond := range hpa.Status.Conditions {
+			if cond.Type == "AbleToScale" && cond.Status == corev1.ConditionFalse {
+				findings = append(findings, Finding{
+					Kind:      "HorizontalPodAutoscaler",
+					Namespace: hpa.Namespace,
+					Name:      hpa.Name,
+					Reason:    "UnableToScale",
+					Detail:    fmt.Sprintf("scale target %s/%s: %s", target.Kind, target.Name, cond.Message),
+				})
+			}
+		}
+	}
+
+	return findings, nil
+}
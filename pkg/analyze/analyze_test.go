@@ -0,0 +1,103 @@
+package analyze
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestScanFindsBrokenObjects(t *testing.T) {
+	const namespace = "default"
+
+	client := fake.NewSimpleClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "crashy", Namespace: namespace},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name: "app",
+						State: corev1.ContainerState{
+							Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff", Message: "exit code 1"},
+						},
+					},
+				},
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "unschedulable", Namespace: namespace},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{
+					{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: "Unschedulable", Message: "0/3 nodes are available"},
+				},
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "healthy", Namespace: namespace},
+		},
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "pending-pvc", Namespace: namespace},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+		},
+		&autoscalingv1.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "no-target", Namespace: namespace},
+		},
+	)
+
+	findings, err := Scan(context.Background(), client, namespace)
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+
+	got := make(map[string]string, len(findings))
+	for _, f := range findings {
+		got[f.Name] = f.Reason
+	}
+
+	want := map[string]string{
+		"crashy":        "CrashLoopBackOff",
+		"unschedulable": "Unschedulable",
+		"pending-pvc":   "Pending",
+		"no-target":     "MissingScaleTarget",
+	}
+
+	for name, reason := range want {
+		if got[name] != reason {
+			t.Errorf("expected a finding for %q with reason %q, got %q", name, reason, got[name])
+		}
+	}
+
+	if _, ok := got["healthy"]; ok {
+		t.Errorf("did not expect a finding for the healthy pod")
+	}
+
+	if len(findings) != len(want) {
+		names := make([]string, 0, len(findings))
+		for _, f := range findings {
+			names = append(names, f.Name)
+		}
+
+		sort.Strings(names)
+
+		t.Fatalf("expected %d findings, got %d: %v", len(want), len(findings), names)
+	}
+}
+
+func TestScanReturnsNoFindingsForAHealthyNamespace(t *testing.T) {
+	client := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "healthy", Namespace: "default"},
+	})
+
+	findings, err := Scan(context.Background(), client, "default")
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+
+	if len(findings) != 0 {
+		t.Fatalf("expected no findings, got %v", findings)
+	}
+}
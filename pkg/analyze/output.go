@@ -0,0 +1,36 @@
+package analyze
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Write renders findings to w in the given format ("json", "yaml", or "text").
+func Write(w io.Writer, format string, findings []Finding) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(findings)
+	case "yaml":
+		enc := yaml.NewEncoder(w)
+		defer enc.Close()
+
+		return enc.Encode(findings)
+	case "text", "":
+		for _, f := range findings {
+			fmt.Fprintf(w, "[%s] %s/%s: %s\n", f.Kind, f.Namespace, f.Name, f.Reason)
+			if f.Explanation != "" {
+				fmt.Fprintf(w, "  %s\n", f.Explanation)
+			}
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+}
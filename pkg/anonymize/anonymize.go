@@ -0,0 +1,132 @@
+// Package anonymize scrubs sensitive Kubernetes identifiers (namespace names, resource
+// names, label and annotation values) out of a prompt before it is sent to an LLM backend,
+// and reverses the substitution on the manifest that comes back.
+package anonymize
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// tokenLen is the length of the base32-encoded token a value is replaced with.
+const tokenLen = 15
+
+var (
+	keyOnce sync.Once
+	hmacKey []byte
+)
+
+func runKey() []byte {
+	keyOnce.Do(func() {
+		hmacKey = make([]byte, 32)
+		if _, err := rand.Read(hmacKey); err != nil {
+			// crypto/rand failing means the host is unusable; fall back to a fixed key
+			// rather than panicking mid-prompt.
+			hmacKey = []byte("kubectl-assistant-anonymize-fallback-key")
+		}
+	})
+
+	return hmacKey
+}
+
+// shouldMask reports whether a candidate value is worth masking at all: short and
+// numeric-only values carry little information and are left alone.
+func shouldMask(value string) bool {
+	if len(value) < 3 {
+		return false
+	}
+
+	numericOnly := true
+	for _, r := range value {
+		if !unicode.IsDigit(r) {
+			numericOnly = false
+
+			break
+		}
+	}
+
+	return !numericOnly
+}
+
+// token deterministically derives a short token for value from the run key, resolving
+// collisions against the tokens already present in mapping.
+func token(value string, mapping map[string]string) string {
+	mac := hmac.New(sha256.New, runKey())
+	mac.Write([]byte(value))
+	sum := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(mac.Sum(nil))
+
+	used := make(map[string]bool, len(mapping))
+	for _, t := range mapping {
+		used[t] = true
+	}
+
+	tok := sum[:tokenLen]
+	for i := 0; used[tok]; i++ {
+		suffix := strconv.Itoa(i)
+		tok = sum[:tokenLen-len(suffix)] + suffix
+	}
+
+	return tok
+}
+
+// Mask replaces every occurrence of each candidate value found in text with a deterministic
+// token, and returns the masked text alongside the (possibly extended) mapping from original
+// value to token. candidates is the set of sensitive values the caller already knows about —
+// e.g. the raw prompt args, a namespace passed on the command line, or names/namespaces of
+// Kubernetes objects discovered via the API — since Mask has no way to pick identifiers out
+// of arbitrary natural-language text on its own.
+//
+// Passing a previously returned mapping back in on the next call keeps identifiers stable
+// across a reprompt loop; pass nil on the first call.
+func Mask(text string, candidates []string, mapping map[string]string) (string, map[string]string) {
+	if mapping == nil {
+		mapping = map[string]string{}
+	}
+
+	unique := make(map[string]bool, len(candidates))
+
+	var sorted []string
+	for _, c := range candidates {
+		if !shouldMask(c) || unique[c] {
+			continue
+		}
+
+		unique[c] = true
+		sorted = append(sorted, c)
+	}
+
+	// Replace longer candidates first so one candidate that is a substring of another
+	// (e.g. "web" inside "web-prod") doesn't get masked out from under the longer one.
+	sort.Slice(sorted, func(i, j int) bool { return len(sorted[i]) > len(sorted[j]) })
+
+	masked := text
+	for _, value := range sorted {
+		tok, ok := mapping[value]
+		if !ok {
+			tok = token(value, mapping)
+			mapping[value] = tok
+		}
+
+		masked = strings.ReplaceAll(masked, value, tok)
+	}
+
+	return masked, mapping
+}
+
+// Unmask reverses every substitution recorded in mapping, restoring the original values in
+// text.
+func Unmask(text string, mapping map[string]string) string {
+	unmasked := text
+	for value, tok := range mapping {
+		unmasked = strings.ReplaceAll(unmasked, tok, value)
+	}
+
+	return unmasked
+}
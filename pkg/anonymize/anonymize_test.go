@@ -0,0 +1,47 @@
+package anonymize
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMaskReplacesCandidatesInFreeText(t *testing.T) {
+	prompt := "create a deployment named web-prod in namespace customer-acme with 3 replicas"
+
+	masked, mapping := Mask(prompt, []string{"web-prod", "customer-acme"}, nil)
+
+	if strings.Contains(masked, "web-prod") || strings.Contains(masked, "customer-acme") {
+		t.Fatalf("expected sensitive identifiers to be masked, got: %s", masked)
+	}
+
+	if len(mapping) != 2 {
+		t.Fatalf("expected 2 mapping entries, got %d: %v", len(mapping), mapping)
+	}
+
+	restored := Unmask(masked, mapping)
+	if restored != prompt {
+		t.Fatalf("Unmask did not restore the original prompt: got %q, want %q", restored, prompt)
+	}
+}
+
+func TestMaskIgnoresShortAndNumericValues(t *testing.T) {
+	masked, mapping := Mask("scale to 5 replicas in ns", []string{"5", "ns"}, nil)
+
+	if masked != "scale to 5 replicas in ns" {
+		t.Fatalf("expected text to be unchanged, got: %s", masked)
+	}
+
+	if len(mapping) != 0 {
+		t.Fatalf("expected no mapping entries for short/numeric candidates, got: %v", mapping)
+	}
+}
+
+func TestMaskIsStableAcrossCalls(t *testing.T) {
+	first, mapping := Mask("namespace customer-acme", []string{"customer-acme"}, nil)
+	second, mapping := Mask("re-describe customer-acme again", []string{"customer-acme"}, mapping)
+
+	firstToken := mapping["customer-acme"]
+	if !strings.Contains(first, firstToken) || !strings.Contains(second, firstToken) {
+		t.Fatalf("expected the same token to be reused across calls: %q, %q", first, second)
+	}
+}
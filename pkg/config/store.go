@@ -0,0 +1,157 @@
+// Package config persists kubectl-assistant's backend registry to
+// $HOME/.kube/assistant.yaml via viper, so registered backends survive across invocations.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+
+	"github.com/Iamvibs/kubernetes-assistant-go/pkg/ai"
+)
+
+const (
+	backendsKey = "backends"
+	defaultKey  = "default"
+)
+
+// Store reads and writes the assistant config file.
+type Store struct {
+	v    *viper.Viper
+	path string
+}
+
+// Load reads the config file from $HOME/.kube/assistant.yaml. If the file does not exist yet,
+// Load returns an empty store; the file is first written when a backend is added or changed.
+func Load() (*Store, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("locating home directory: %w", err)
+	}
+
+	path := filepath.Join(home, ".kube", "assistant.yaml")
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("yaml")
+
+	if err := v.ReadInConfig(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("reading config %s: %w", path, err)
+	}
+
+	return &Store{v: v, path: path}, nil
+}
+
+// Backends returns every backend persisted in the config file.
+func (s *Store) Backends() ([]ai.Config, error) {
+	var cfgs []ai.Config
+	if err := s.v.UnmarshalKey(backendsKey, &cfgs); err != nil {
+		return nil, fmt.Errorf("parsing backends: %w", err)
+	}
+
+	return cfgs, nil
+}
+
+// Default returns the name of the default backend, or "" if none is set.
+func (s *Store) Default() string {
+	return s.v.GetString(defaultKey)
+}
+
+// AddBackend upserts a backend by name and persists the config file.
+func (s *Store) AddBackend(cfg ai.Config) error {
+	cfgs, err := s.Backends()
+	if err != nil {
+		return err
+	}
+
+	replaced := false
+	for i, existing := range cfgs {
+		if existing.Name == cfg.Name {
+			cfgs[i] = cfg
+			replaced = true
+
+			break
+		}
+	}
+
+	if !replaced {
+		cfgs = append(cfgs, cfg)
+	}
+
+	s.v.Set(backendsKey, cfgs)
+	if s.Default() == "" {
+		s.v.Set(defaultKey, cfg.Name)
+	}
+
+	return s.save()
+}
+
+// RemoveBackend deletes a backend by name and persists the config file.
+func (s *Store) RemoveBackend(name string) error {
+	cfgs, err := s.Backends()
+	if err != nil {
+		return err
+	}
+
+	filtered := cfgs[:0]
+	for _, existing := range cfgs {
+		if existing.Name != name {
+			filtered = append(filtered, existing)
+		}
+	}
+
+	s.v.Set(backendsKey, filtered)
+	if s.Default() == name {
+		s.v.Set(defaultKey, "")
+	}
+
+	return s.save()
+}
+
+// SetDefault marks name as the default backend and persists the config file. It returns an
+// error if no backend is registered under name.
+func (s *Store) SetDefault(name string) error {
+	cfgs, err := s.Backends()
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, cfg := range cfgs {
+		if cfg.Name == name {
+			found = true
+
+			break
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("no such backend: %s", name)
+	}
+
+	s.v.Set(defaultKey, name)
+
+	return s.save()
+}
+
+func (s *Store) save() error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("creating config directory: %w", err)
+	}
+
+	if err := s.v.WriteConfigAs(s.path); err != nil {
+		return fmt.Errorf("writing config %s: %w", s.path, err)
+	}
+
+	// The file holds backend passwords/API keys in plaintext; viper writes it with the
+	// default (world-readable) mode, so tighten it down ourselves.
+	if err := os.Chmod(s.path, 0o600); err != nil {
+		return fmt.Errorf("restricting permissions on %s: %w", s.path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Iamvibs/kubernetes-assistant-go/pkg/ai"
+)
+
+func TestStoreAddRemoveDefaultRoundTrip(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	store, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	if err := store.AddBackend(ai.Config{Name: "a", Provider: "localai", BaseURL: "http://localhost:8080"}); err != nil {
+		t.Fatalf("AddBackend(a): %v", err)
+	}
+
+	if err := store.AddBackend(ai.Config{Name: "b", Provider: "localai", BaseURL: "http://localhost:8081"}); err != nil {
+		t.Fatalf("AddBackend(b): %v", err)
+	}
+
+	if got := store.Default(); got != "a" {
+		t.Fatalf("expected first-added backend to become the default, got %q", got)
+	}
+
+	if err := store.SetDefault("b"); err != nil {
+		t.Fatalf("SetDefault(b): %v", err)
+	}
+
+	if got := store.Default(); got != "b" {
+		t.Fatalf("expected default to be %q, got %q", "b", got)
+	}
+
+	if err := store.RemoveBackend("b"); err != nil {
+		t.Fatalf("RemoveBackend(b): %v", err)
+	}
+
+	if got := store.Default(); got != "" {
+		t.Fatalf("expected default to be cleared after removing it, got %q", got)
+	}
+
+	cfgs, err := store.Backends()
+	if err != nil {
+		t.Fatalf("Backends: %v", err)
+	}
+
+	if len(cfgs) != 1 || cfgs[0].Name != "a" {
+		t.Fatalf("expected only backend %q to remain, got %v", "a", cfgs)
+	}
+
+	// Reloading from disk should see the same state a fresh process would.
+	reloaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load (reload): %v", err)
+	}
+
+	cfgs, err = reloaded.Backends()
+	if err != nil {
+		t.Fatalf("Backends (reload): %v", err)
+	}
+
+	if len(cfgs) != 1 || cfgs[0].Name != "a" {
+		t.Fatalf("expected reloaded store to see backend %q, got %v", "a", cfgs)
+	}
+}
+
+func TestStoreSetDefaultRejectsUnknownName(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	store, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	if err := store.SetDefault("nope"); err == nil {
+		t.Fatal("expected an error setting an unregistered backend as default")
+	}
+}
+
+func TestStoreSavedFileIsPrivate(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	store, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	if err := store.AddBackend(ai.Config{Name: "a", Provider: "localai", BaseURL: "http://localhost:8080"}); err != nil {
+		t.Fatalf("AddBackend: %v", err)
+	}
+
+	info, err := os.Stat(filepath.Join(home, ".kube", "assistant.yaml"))
+	if err != nil {
+		t.Fatalf("Stat: %v", err)
+	}
+
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Fatalf("expected assistant.yaml to be 0600, got %o", perm)
+	}
+}
+
+func TestLoadPropagatesPermissionDenied(t *testing.T) {
+	if os.Getuid() == 0 {
+		t.Skip("permission bits are not enforced when running as root")
+	}
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	kubeDir := filepath.Join(home, ".kube")
+	if err := os.MkdirAll(kubeDir, 0o700); err != nil {
+		t.Fatalf("MkdirAll: %v", err)
+	}
+
+	path := filepath.Join(kubeDir, "assistant.yaml")
+	if err := os.WriteFile(path, []byte("backends: []\n"), 0o000); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if _, err := Load(); err == nil {
+		t.Fatal("expected Load to surface a permission-denied error instead of treating it as \"no config yet\"")
+	}
+}